@@ -0,0 +1,228 @@
+package retricon
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"math"
+)
+
+// AnimationMode selects how successive frames of an animated identicon
+// are derived from the base identicon.
+type AnimationMode int
+
+const (
+	// ColorCycle keeps the pixel layout and background color fixed and
+	// rotates the tile hue a little further on each frame.
+	ColorCycle AnimationMode = iota
+	// PixelDrift keeps the colors fixed and re-walks the hash stream with a
+	// per-frame salt to phase-shift which cells are on.
+	PixelDrift
+	// SymmetryCycle keeps the colors and underlying hash fixed but cycles
+	// through the four symmetry modes (none, vertical, horizontal, both).
+	SymmetryCycle
+)
+
+// AnimatedOptions configures NewAnimated. It embeds Options so the base
+// identicon (tile count, size, padding, ...) is specified the same way
+// as for a static one.
+type AnimatedOptions struct {
+	Options
+
+	// Frames is the number of frames in the loop. Defaults to 8.
+	Frames int
+	// DelayMS is the per-frame delay in milliseconds. Defaults to 100.
+	DelayMS int
+	// Mode selects how frames are derived. Defaults to ColorCycle.
+	Mode AnimationMode
+	// LoopCount is the GIF loop count; 0 loops forever.
+	LoopCount int
+}
+
+// NewAnimated produces a looping animated identicon as a multi-frame
+// GIF. Frames are derived deterministically from name, so the same name
+// and options always produce the same animation.
+func NewAnimated(name string, opts AnimatedOptions) (*gif.GIF, error) {
+	if opts.Frames < 1 {
+		opts.Frames = 8
+	}
+	if opts.DelayMS <= 0 {
+		opts.DelayMS = 100
+	}
+
+	base, err := planRender(name, opts.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	frames := make([]*renderPlan, opts.Frames)
+	for i := range frames {
+		frame, err := animationFrame(name, opts, base, i)
+		if err != nil {
+			return nil, err
+		}
+		frames[i] = frame
+	}
+
+	palette := animationPalette(base, frames)
+	delay := opts.DelayMS / 10
+
+	out := &gif.GIF{LoopCount: opts.LoopCount}
+	for _, frame := range frames {
+		var im *image.Paletted
+		if opts.Size > 0 && opts.Size != frame.canvasSize {
+			// Same resize-then-requantize approach as EncodeGIF: render at
+			// the requested Size, then draw the RGBA result onto a
+			// Paletted image so it quantizes onto the shared animation
+			// palette instead of staying at the natural canvas size.
+			resized := frame.render(opts.Options)
+			im = image.NewPaletted(resized.Bounds(), palette)
+			draw.Draw(im, im.Bounds(), resized, image.Point{}, draw.Src)
+		} else {
+			im = image.NewPaletted(image.Rect(0, 0, frame.canvasSize, frame.canvasSize), palette)
+			frame.draw(im)
+		}
+		out.Image = append(out.Image, im)
+		out.Delay = append(out.Delay, delay)
+	}
+	return out, nil
+}
+
+// animationFrame derives the renderPlan for frame i according to mode.
+func animationFrame(name string, opts AnimatedOptions, base *renderPlan, i int) (*renderPlan, error) {
+	switch opts.Mode {
+	case PixelDrift:
+		driftOpts := opts.Options
+		plan, err := planRender(fmt.Sprintf("%s\x00frame%d", name, i), driftOpts)
+		if err != nil {
+			return nil, err
+		}
+		plan.bgColor = base.bgColor
+		plan.tileColor = base.tileColor
+		return plan, nil
+	case SymmetryCycle:
+		cycleOpts := opts.Options
+		switch i % 4 {
+		case 0:
+			cycleOpts.VerticalSym, cycleOpts.HorizontalSym = false, false
+		case 1:
+			cycleOpts.VerticalSym, cycleOpts.HorizontalSym = true, false
+		case 2:
+			cycleOpts.VerticalSym, cycleOpts.HorizontalSym = false, true
+		case 3:
+			cycleOpts.VerticalSym, cycleOpts.HorizontalSym = true, true
+		}
+		plan, err := planRender(name, cycleOpts)
+		if err != nil {
+			return nil, err
+		}
+		plan.bgColor = base.bgColor
+		plan.tileColor = base.tileColor
+		return plan, nil
+	case ColorCycle:
+		fallthrough
+	default:
+		plan := *base
+		degrees := 360 * float64(i) / float64(opts.Frames)
+		plan.tileColor = hueRotate(base.tileColor, degrees)
+		return &plan, nil
+	}
+}
+
+// animationPalette builds the single global palette shared by every
+// frame, so gif.EncodeAll can reuse one color table for the whole loop.
+func animationPalette(base *renderPlan, frames []*renderPlan) color.Palette {
+	palette := color.Palette{base.bgColor}
+	seen := map[color.RGBA]bool{base.bgColor: true}
+	for _, frame := range frames {
+		if !seen[frame.tileColor] {
+			seen[frame.tileColor] = true
+			palette = append(palette, frame.tileColor)
+		}
+	}
+	return palette
+}
+
+// hueRotate rotates c's hue by degrees, preserving its saturation and
+// lightness, and returns the resulting RGBA color.
+func hueRotate(c color.RGBA, degrees float64) color.RGBA {
+	h, s, l := rgbToHSL(c)
+	h = math.Mod(h+degrees, 360)
+	if h < 0 {
+		h += 360
+	}
+	r, g, b := hslToRGB(h, s, l)
+	return color.RGBA{R: r, G: g, B: b, A: c.A}
+}
+
+// rgbToHSL converts an RGBA color to hue (degrees), saturation, and
+// lightness, all in the ranges used by hslToRGB.
+func rgbToHSL(c color.RGBA) (h, s, l float64) {
+	r := float64(c.R) / 255
+	g := float64(c.G) / 255
+	b := float64(c.B) / 255
+
+	max := math.Max(r, math.Max(g, b))
+	min := math.Min(r, math.Min(g, b))
+	l = (max + min) / 2
+
+	if max == min {
+		return 0, 0, l
+	}
+
+	d := max - min
+	if l > 0.5 {
+		s = d / (2 - max - min)
+	} else {
+		s = d / (max + min)
+	}
+
+	switch max {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h, s, l
+}
+
+// hslToRGB converts hue (degrees), saturation, and lightness back to an
+// RGB triple in the 0-255 range.
+func hslToRGB(h, s, l float64) (r, g, b uint8) {
+	if s == 0 {
+		v := uint8(math.Round(l * 255))
+		return v, v, v
+	}
+
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+
+	var rf, gf, bf float64
+	switch {
+	case h < 60:
+		rf, gf, bf = c, x, 0
+	case h < 120:
+		rf, gf, bf = x, c, 0
+	case h < 180:
+		rf, gf, bf = 0, c, x
+	case h < 240:
+		rf, gf, bf = 0, x, c
+	case h < 300:
+		rf, gf, bf = x, 0, c
+	default:
+		rf, gf, bf = c, 0, x
+	}
+
+	return uint8(math.Round((rf + m) * 255)),
+		uint8(math.Round((gf + m) * 255)),
+		uint8(math.Round((bf + m) * 255))
+}
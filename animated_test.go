@@ -0,0 +1,89 @@
+package retricon
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestNewAnimated(t *testing.T) {
+	modes := []AnimationMode{ColorCycle, PixelDrift, SymmetryCycle}
+
+	for _, mode := range modes {
+		opts := AnimatedOptions{
+			Options: Options{Tiles: 5, TileSize: 10},
+			Frames:  6,
+			DelayMS: 80,
+			Mode:    mode,
+		}
+
+		anim, err := NewAnimated("test", opts)
+		if err != nil {
+			t.Fatalf("NewAnimated with mode %d failed: %v", mode, err)
+		}
+		if len(anim.Image) != opts.Frames {
+			t.Errorf("expected %d frames, got %d", opts.Frames, len(anim.Image))
+		}
+		if len(anim.Delay) != opts.Frames {
+			t.Errorf("expected %d delays, got %d", opts.Frames, len(anim.Delay))
+		}
+	}
+}
+
+func TestNewAnimatedDefaults(t *testing.T) {
+	anim, err := NewAnimated("test", AnimatedOptions{Options: Options{Tiles: 4, TileSize: 8}})
+	if err != nil {
+		t.Fatalf("NewAnimated failed: %v", err)
+	}
+	if len(anim.Image) != 8 {
+		t.Errorf("expected default of 8 frames, got %d", len(anim.Image))
+	}
+}
+
+func TestNewAnimatedSameNameSameFrames(t *testing.T) {
+	opts := AnimatedOptions{Options: Options{Tiles: 5, TileSize: 10}, Frames: 4}
+
+	anim1, _ := NewAnimated("test", opts)
+	anim2, _ := NewAnimated("test", opts)
+
+	if len(anim1.Image) != len(anim2.Image) {
+		t.Fatal("expected same frame count for the same name")
+	}
+	for i := range anim1.Image {
+		if !compareImages(anim1.Image[i], anim2.Image[i]) {
+			t.Errorf("frame %d differs between identical runs", i)
+		}
+	}
+}
+
+func TestNewAnimatedHonorsSize(t *testing.T) {
+	opts := AnimatedOptions{
+		Options: Options{Tiles: 5, TileSize: 10, Size: 200},
+		Frames:  4,
+	}
+
+	anim, err := NewAnimated("test", opts)
+	if err != nil {
+		t.Fatalf("NewAnimated failed: %v", err)
+	}
+	for i, frame := range anim.Image {
+		b := frame.Bounds()
+		if b.Dx() != 200 || b.Dy() != 200 {
+			t.Errorf("frame %d: expected 200x200, got %dx%d", i, b.Dx(), b.Dy())
+		}
+	}
+}
+
+func TestHueRotateRoundTrip(t *testing.T) {
+	c := color.RGBA{R: 200, G: 40, B: 90, A: 255}
+	rotated := hueRotate(c, 360)
+
+	diff := func(a, b uint8) int {
+		if a > b {
+			return int(a - b)
+		}
+		return int(b - a)
+	}
+	if diff(rotated.R, c.R) > 1 || diff(rotated.G, c.G) > 1 || diff(rotated.B, c.B) > 1 {
+		t.Errorf("rotating by 360 degrees should be a no-op, got %+v want %+v", rotated, c)
+	}
+}
@@ -0,0 +1,20 @@
+package retricon
+
+import "testing"
+
+// BenchmarkRenderLarge exercises the draw.Draw-based render path at a
+// Gravatar-style large canvas (8x8 tiles at 128px each, 1024px square),
+// the case that motivated moving off per-pixel Set calls.
+func BenchmarkRenderLarge(b *testing.B) {
+	opts := Options{
+		Tiles:    8,
+		TileSize: 128,
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewWithOptions("benchmark", opts); err != nil {
+			b.Fatalf("NewWithOptions failed: %v", err)
+		}
+	}
+}
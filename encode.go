@@ -0,0 +1,107 @@
+package retricon
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"golang.org/x/image/bmp"
+)
+
+// Format identifies the raster encoding used by Encode.
+type Format int
+
+const (
+	FormatPNG Format = iota
+	FormatJPEG
+	FormatGIF
+	FormatBMP
+)
+
+// Encode renders the identicon for name and writes it to w in the given
+// format. quality is only consulted for FormatJPEG; pass 0 to use the
+// jpeg package's default quality.
+func Encode(w io.Writer, name string, opts Options, format Format, quality int) error {
+	switch format {
+	case FormatPNG:
+		return EncodePNG(w, name, opts)
+	case FormatJPEG:
+		return EncodeJPEG(w, name, opts, quality)
+	case FormatGIF:
+		return EncodeGIF(w, name, opts)
+	case FormatBMP:
+		return EncodeBMP(w, name, opts)
+	default:
+		return errors.New("retricon: unknown encode format")
+	}
+}
+
+// EncodePNG renders the identicon for name and writes it to w as a PNG.
+func EncodePNG(w io.Writer, name string, opts Options) error {
+	plan, err := planRender(name, opts)
+	if err != nil {
+		return err
+	}
+	return png.Encode(w, plan.render(opts))
+}
+
+// EncodeJPEG renders the identicon for name and writes it to w as a
+// JPEG. quality follows image/jpeg's Options.Quality convention (1-100);
+// pass 0 to use jpeg.DefaultQuality.
+func EncodeJPEG(w io.Writer, name string, opts Options, quality int) error {
+	plan, err := planRender(name, opts)
+	if err != nil {
+		return err
+	}
+
+	if quality == 0 {
+		quality = jpeg.DefaultQuality
+	}
+	return jpeg.Encode(w, plan.render(opts), &jpeg.Options{Quality: quality})
+}
+
+// EncodeGIF renders the identicon for name and writes it to w as a
+// single-frame paletted GIF. The palette is built from the identicon's
+// background and tile colors, so the frame encodes without dithering. If
+// opts.Size resamples the identicon, the resampled pixels are quantized
+// back onto that same two-color palette.
+func EncodeGIF(w io.Writer, name string, opts Options) error {
+	plan, err := planRender(name, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.Size <= 0 || opts.Size == plan.canvasSize {
+		im := newPalettedImage(plan)
+		plan.draw(im)
+		return gif.Encode(w, im, nil)
+	}
+
+	resized := plan.render(opts)
+	im := image.NewPaletted(resized.Bounds(), color.Palette{plan.bgColor, plan.tileColor})
+	draw.Draw(im, im.Bounds(), resized, image.Point{}, draw.Src)
+	return gif.Encode(w, im, nil)
+}
+
+// EncodeBMP renders the identicon for name and writes it to w as a BMP.
+func EncodeBMP(w io.Writer, name string, opts Options) error {
+	plan, err := planRender(name, opts)
+	if err != nil {
+		return err
+	}
+	return bmp.Encode(w, plan.render(opts))
+}
+
+// newPalettedImage allocates an *image.Paletted sized for plan, with a
+// palette built from the plan's background and tile colors. EncodeGIF
+// uses it for output at the natural canvas size; NewAnimated builds its
+// own shared palette with animationPalette instead.
+func newPalettedImage(plan *renderPlan) *image.Paletted {
+	palette := color.Palette{plan.bgColor, plan.tileColor}
+	return image.NewPaletted(image.Rect(0, 0, plan.canvasSize, plan.canvasSize), palette)
+}
@@ -0,0 +1,58 @@
+package retricon
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeFormats(t *testing.T) {
+	opts := Options{
+		Tiles:    5,
+		TileSize: 10,
+	}
+
+	testCases := []struct {
+		name   string
+		encode func(w *bytes.Buffer) error
+	}{
+		{"png", func(w *bytes.Buffer) error { return EncodePNG(w, "test", opts) }},
+		{"jpeg", func(w *bytes.Buffer) error { return EncodeJPEG(w, "test", opts, 0) }},
+		{"gif", func(w *bytes.Buffer) error { return EncodeGIF(w, "test", opts) }},
+		{"bmp", func(w *bytes.Buffer) error { return EncodeBMP(w, "test", opts) }},
+	}
+
+	for _, tc := range testCases {
+		var buf bytes.Buffer
+		if err := tc.encode(&buf); err != nil {
+			t.Errorf("Encode%s failed: %v", tc.name, err)
+			continue
+		}
+		if buf.Len() == 0 {
+			t.Errorf("Encode%s wrote no bytes", tc.name)
+		}
+	}
+}
+
+func TestEncodeDispatcher(t *testing.T) {
+	opts := Options{Tiles: 4, TileSize: 8}
+
+	for _, format := range []Format{FormatPNG, FormatJPEG, FormatGIF, FormatBMP} {
+		var buf bytes.Buffer
+		if err := Encode(&buf, "test", opts, format, 0); err != nil {
+			t.Errorf("Encode with format %d failed: %v", format, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, "test", opts, Format(99), 0); err == nil {
+		t.Error("Expected error for unknown format, got nil")
+	}
+}
+
+func TestEncodeInvalidOptions(t *testing.T) {
+	var buf bytes.Buffer
+	err := EncodePNG(&buf, "test", Options{Tiles: 0, TileSize: 10})
+	if err == nil {
+		t.Error("Expected error for invalid tiles, got nil")
+	}
+}
@@ -0,0 +1,69 @@
+package retricon
+
+import "image/color"
+
+// Palette is a fixed set of colors an identicon's tiles and background
+// are drawn from, modeled after the standard library's color/palette.
+type Palette []color.RGBA
+
+// PaletteMaterial is Google's Material Design primary palette (500
+// shades), one representative color per hue.
+var PaletteMaterial = Palette{
+	{R: 0xF4, G: 0x43, B: 0x36, A: 255}, // Red
+	{R: 0xE9, G: 0x1E, B: 0x63, A: 255}, // Pink
+	{R: 0x9C, G: 0x27, B: 0xB0, A: 255}, // Purple
+	{R: 0x67, G: 0x3A, B: 0xB7, A: 255}, // Deep Purple
+	{R: 0x3F, G: 0x51, B: 0xB5, A: 255}, // Indigo
+	{R: 0x21, G: 0x96, B: 0xF3, A: 255}, // Blue
+	{R: 0x00, G: 0xBC, B: 0xD4, A: 255}, // Cyan
+	{R: 0x00, G: 0x96, B: 0x88, A: 255}, // Teal
+	{R: 0x4C, G: 0xAF, B: 0x50, A: 255}, // Green
+	{R: 0x8B, G: 0xC3, B: 0x4A, A: 255}, // Light Green
+	{R: 0xFF, G: 0xEB, B: 0x3B, A: 255}, // Yellow
+	{R: 0xFF, G: 0x98, B: 0x00, A: 255}, // Orange
+	{R: 0xFF, G: 0x57, B: 0x22, A: 255}, // Deep Orange
+	{R: 0x79, G: 0x55, B: 0x48, A: 255}, // Brown
+	{R: 0x60, G: 0x7D, B: 0x8B, A: 255}, // Blue Grey
+}
+
+// PaletteTailwind is Tailwind CSS's 500-weight swatch, one per hue.
+var PaletteTailwind = Palette{
+	{R: 0xEF, G: 0x44, B: 0x44, A: 255}, // red-500
+	{R: 0xF9, G: 0x73, B: 0x16, A: 255}, // orange-500
+	{R: 0xF5, G: 0x9E, B: 0x0B, A: 255}, // amber-500
+	{R: 0xEA, G: 0xB3, B: 0x08, A: 255}, // yellow-500
+	{R: 0x84, G: 0xCC, B: 0x16, A: 255}, // lime-500
+	{R: 0x22, G: 0xC5, B: 0x5E, A: 255}, // green-500
+	{R: 0x10, G: 0xB9, B: 0x81, A: 255}, // emerald-500
+	{R: 0x14, G: 0xB8, B: 0xA6, A: 255}, // teal-500
+	{R: 0x06, G: 0xB6, B: 0xD4, A: 255}, // cyan-500
+	{R: 0x3B, G: 0x82, B: 0xF6, A: 255}, // blue-500
+	{R: 0x63, G: 0x66, B: 0xF1, A: 255}, // indigo-500
+	{R: 0x8B, G: 0x5C, B: 0xF6, A: 255}, // violet-500
+	{R: 0xA8, G: 0x55, B: 0xF7, A: 255}, // purple-500
+	{R: 0xD9, G: 0x46, B: 0xEF, A: 255}, // fuchsia-500
+	{R: 0xEC, G: 0x48, B: 0x99, A: 255}, // pink-500
+	{R: 0xF4, G: 0x3F, B: 0x5E, A: 255}, // rose-500
+}
+
+// PaletteSolarized is Ethan Schoonover's Solarized accent colors.
+var PaletteSolarized = Palette{
+	{R: 0xB5, G: 0x89, B: 0x00, A: 255}, // yellow
+	{R: 0xCB, G: 0x4B, B: 0x16, A: 255}, // orange
+	{R: 0xDC, G: 0x32, B: 0x2F, A: 255}, // red
+	{R: 0xD3, G: 0x36, B: 0x82, A: 255}, // magenta
+	{R: 0x6C, G: 0x71, B: 0xC4, A: 255}, // violet
+	{R: 0x26, G: 0x8B, B: 0xD2, A: 255}, // blue
+	{R: 0x2A, G: 0xA1, B: 0x98, A: 255}, // cyan
+	{R: 0x85, G: 0x99, B: 0x00, A: 255}, // green
+}
+
+// PaletteGitHubContrib is GitHub's contribution-graph green scale, from
+// lightest to darkest.
+var PaletteGitHubContrib = Palette{
+	{R: 0xEB, G: 0xED, B: 0xF0, A: 255},
+	{R: 0x9B, G: 0xE9, B: 0xA8, A: 255},
+	{R: 0x40, G: 0xC4, B: 0x63, A: 255},
+	{R: 0x30, G: 0xA1, B: 0x4E, A: 255},
+	{R: 0x21, G: 0x6E, B: 0x39, A: 255},
+}
@@ -0,0 +1,70 @@
+package retricon
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestNamedPalettes(t *testing.T) {
+	palettes := []Palette{PaletteMaterial, PaletteTailwind, PaletteSolarized, PaletteGitHubContrib}
+
+	for i, palette := range palettes {
+		if len(palette) == 0 {
+			t.Errorf("palette %d is empty", i)
+		}
+
+		opts := Options{
+			Tiles:    5,
+			TileSize: 10,
+			Palette:  palette,
+		}
+
+		img, err := NewWithOptions("test", opts)
+		if err != nil {
+			t.Errorf("NewWithOptions with palette %d failed: %v", i, err)
+			continue
+		}
+		if img == nil {
+			t.Errorf("Generated image for palette %d is nil", i)
+		}
+	}
+}
+
+func TestPaletteSameNameSameColors(t *testing.T) {
+	opts := Options{Tiles: 5, TileSize: 10, Palette: PaletteMaterial}
+
+	img1, err1 := NewWithOptions("test", opts)
+	img2, err2 := NewWithOptions("test", opts)
+	if err1 != nil || err2 != nil {
+		t.Fatalf("NewWithOptions failed: %v / %v", err1, err2)
+	}
+
+	if !compareImages(img1, img2) {
+		t.Error("identicons generated from the same name and palette should be identical")
+	}
+}
+
+func TestPaletteColorsComeFromPalette(t *testing.T) {
+	opts := Options{Tiles: 4, TileSize: 10, Palette: PaletteGitHubContrib}
+
+	img, err := NewWithOptions("test", opts)
+	if err != nil {
+		t.Fatalf("NewWithOptions failed: %v", err)
+	}
+
+	inPalette := func(c color.RGBA) bool {
+		for _, p := range PaletteGitHubContrib {
+			if p == c {
+				return true
+			}
+		}
+		return false
+	}
+
+	bounds := img.Bounds()
+	r, g, b, a := img.At(bounds.Min.X, bounds.Min.Y).RGBA()
+	c := color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: uint8(a >> 8)}
+	if !inPalette(c) {
+		t.Errorf("background color %+v is not a member of PaletteGitHubContrib", c)
+	}
+}
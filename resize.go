@@ -0,0 +1,59 @@
+package retricon
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+// render draws p at its natural canvas size and, if opts.Size is set,
+// resamples the result to exactly Size x Size using opts.Method.
+func (p *renderPlan) render(opts Options) *image.RGBA {
+	im := image.NewRGBA(image.Rect(0, 0, p.canvasSize, p.canvasSize))
+	p.draw(im)
+
+	if opts.Size <= 0 || opts.Size == p.canvasSize {
+		return im
+	}
+	return resize(im, opts, p.bgColor)
+}
+
+// resize resamples src to opts.Size x opts.Size. MethodScale letterboxes
+// with bg; MethodCrop fills the square and trims overflow. The default
+// interpolator is NearestNeighbor, which keeps tile edges crisp; callers
+// can ask for a smoother CatmullRom resample via opts.Interpolator.
+//
+// src is always square here (render always produces a p.canvasSize x
+// p.canvasSize image), so scale and scaledW/scaledH come out identical
+// for both methods and the crop/letterbox distinction only becomes
+// observable once a caller feeds resize a non-square src.
+func resize(src image.Image, opts Options, bg color.RGBA) *image.RGBA {
+	interp := opts.Interpolator
+	if interp == nil {
+		interp = xdraw.NearestNeighbor
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, opts.Size, opts.Size))
+	srcBounds := src.Bounds()
+	srcW, srcH := float64(srcBounds.Dx()), float64(srcBounds.Dy())
+	size := float64(opts.Size)
+
+	switch opts.Method {
+	case MethodCrop:
+		scale := math.Max(size/srcW, size/srcH)
+		scaledW, scaledH := int(math.Round(srcW*scale)), int(math.Round(srcH*scale))
+		offX, offY := (opts.Size-scaledW)/2, (opts.Size-scaledH)/2
+		dr := image.Rect(offX, offY, offX+scaledW, offY+scaledH)
+		interp.Scale(dst, dr, src, srcBounds, xdraw.Src, nil)
+	default: // MethodScale
+		xdraw.Draw(dst, dst.Bounds(), &image.Uniform{bg}, image.Point{}, xdraw.Src)
+		scale := math.Min(size/srcW, size/srcH)
+		scaledW, scaledH := int(math.Round(srcW*scale)), int(math.Round(srcH*scale))
+		offX, offY := (opts.Size-scaledW)/2, (opts.Size-scaledH)/2
+		dr := image.Rect(offX, offY, offX+scaledW, offY+scaledH)
+		interp.Scale(dst, dr, src, srcBounds, xdraw.Over, nil)
+	}
+	return dst
+}
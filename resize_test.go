@@ -0,0 +1,36 @@
+package retricon
+
+import "testing"
+
+func TestResizeMethods(t *testing.T) {
+	methods := []ResizeMethod{MethodScale, MethodCrop}
+
+	for _, method := range methods {
+		opts := Options{
+			Tiles:    5,
+			TileSize: 10,
+			Size:     128,
+			Method:   method,
+		}
+
+		img, err := NewWithOptions("test", opts)
+		if err != nil {
+			t.Fatalf("NewWithOptions with method %d failed: %v", method, err)
+		}
+
+		if img.Bounds().Dx() != 128 || img.Bounds().Dy() != 128 {
+			t.Errorf("expected 128x128, got %dx%d", img.Bounds().Dx(), img.Bounds().Dy())
+		}
+	}
+}
+
+func TestResizeDefaultsToNaturalSize(t *testing.T) {
+	img, err := NewWithOptions("test", Options{Tiles: 5, TileSize: 10})
+	if err != nil {
+		t.Fatalf("NewWithOptions failed: %v", err)
+	}
+
+	if img.Bounds().Dx() != 50 || img.Bounds().Dy() != 50 {
+		t.Errorf("expected natural 50x50 canvas, got %dx%d", img.Bounds().Dx(), img.Bounds().Dy())
+	}
+}
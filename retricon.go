@@ -6,8 +6,11 @@ import (
 	"errors"
 	"image"
 	"image/color"
+	"image/draw"
 	"math"
 	"strings"
+
+	xdraw "golang.org/x/image/draw"
 )
 
 // Style represents predefined configurations for retricon generation
@@ -35,8 +38,36 @@ type Options struct {
 	MaxFill       float64
 	VerticalSym   bool
 	HorizontalSym bool
+
+	// Size, if non-zero, resamples the rendered identicon to exactly
+	// Size x Size pixels instead of its natural Tiles*TileSize-derived
+	// dimensions. Method controls how the resample handles any aspect
+	// mismatch, and Interpolator overrides the resampling algorithm.
+	Size         int
+	Method       ResizeMethod
+	Interpolator xdraw.Interpolator
+
+	// Palette, if set, constrains tile/background colors to this set
+	// instead of freeform RGB derived from the hash: idHash indexes into
+	// Palette to pick two colors per name, sorted darker-first. TileColor
+	// and BgColor, if left unset, default to 1 and 0 respectively to pick
+	// the lighter and darker of those two colors; they index into that
+	// per-name pair, not directly into the full Palette.
+	Palette Palette
 }
 
+// ResizeMethod selects how Options.Size reconciles a mismatch between
+// the natural canvas size and the requested output size.
+type ResizeMethod int
+
+const (
+	// MethodScale fits the whole identicon within Size x Size, letterboxing
+	// any leftover space with the background color.
+	MethodScale ResizeMethod = iota
+	// MethodCrop fills Size x Size entirely, trimming any overflow.
+	MethodCrop
+)
+
 // ApplyStyle configures options based on predefined styles
 func (o *Options) ApplyStyle(style Style) error {
 	switch style {
@@ -117,6 +148,34 @@ func New(name string, style ...Style) (image.Image, error) {
 
 // NewWithOptions creates a new reticon image with custom options
 func NewWithOptions(name string, opts Options) (image.Image, error) {
+	plan, err := planRender(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return plan.render(opts), nil
+}
+
+// renderPlan holds the resolved geometry and colors needed to draw a
+// generated identicon into any draw.Image, independent of the concrete
+// image type the caller wants (RGBA, paletted, ...).
+type renderPlan struct {
+	raw         *rawData
+	pic         [][]int
+	dimension   int
+	tiles       int
+	tileSize    int
+	tilePadding int
+	imgPadding  int
+	tileWidth   int
+	canvasSize  int
+	bgColor     color.RGBA
+	tileColor   color.RGBA
+}
+
+// planRender resolves an Options value into a renderPlan: it hashes the
+// name, lays out the pixel grid, and parses the background/tile colors,
+// but stops short of allocating or drawing into an actual image.
+func planRender(name string, opts Options) (*renderPlan, error) {
 	if opts.Tiles < 1 {
 		return nil, errors.New("tiles must be greater than 0")
 	}
@@ -135,7 +194,7 @@ func NewWithOptions(name string, opts Options) (image.Image, error) {
 
 	_, isTileColorInt := opts.TileColor.(int)
 	_, isBgColorInt := opts.BgColor.(int)
-	useColor = isTileColorInt || isBgColorInt
+	useColor = isTileColorInt || isBgColorInt || len(opts.Palette) > 0
 
 	var raw *rawData
 	var err error
@@ -144,13 +203,13 @@ func NewWithOptions(name string, opts Options) (image.Image, error) {
 	mid := int(math.Ceil(float64(dimension) / 2.0))
 
 	if opts.VerticalSym && opts.HorizontalSym {
-		raw, err = idHash(name, mid*mid, opts.MinFill, opts.MaxFill, useColor)
+		raw, err = idHash(name, mid*mid, opts.MinFill, opts.MaxFill, useColor, opts.Palette)
 		if err != nil {
 			return nil, err
 		}
 		pic = fillPixelsCentSym(raw, dimension)
 	} else if opts.VerticalSym || opts.HorizontalSym {
-		raw, err = idHash(name, mid*dimension, opts.MinFill, opts.MaxFill, useColor)
+		raw, err = idHash(name, mid*dimension, opts.MinFill, opts.MaxFill, useColor, opts.Palette)
 		if err != nil {
 			return nil, err
 		}
@@ -160,13 +219,25 @@ func NewWithOptions(name string, opts Options) (image.Image, error) {
 			pic = fillPixelsHoriSym(raw, dimension)
 		}
 	} else {
-		raw, err = idHash(name, dimension*dimension, opts.MinFill, opts.MaxFill, useColor)
+		raw, err = idHash(name, dimension*dimension, opts.MinFill, opts.MaxFill, useColor, opts.Palette)
 		if err != nil {
 			return nil, err
 		}
 		pic = fillPixels(raw, dimension)
 	}
 
+	// A palette entry is picked by index, same as the existing int
+	// TileColor/BgColor convention; default to the darker/lighter pick
+	// idHash already sorted into raw.Colors[0]/[1] when unspecified.
+	if len(opts.Palette) > 0 {
+		if opts.TileColor == nil {
+			opts.TileColor = 1
+		}
+		if opts.BgColor == nil {
+			opts.BgColor = 0
+		}
+	}
+
 	// Default to transparent background if not specified
 	if opts.BgColor == nil {
 		opts.BgColor = color.RGBA{}
@@ -184,34 +255,40 @@ func NewWithOptions(name string, opts Options) (image.Image, error) {
 	tileWidth := opts.TileSize + opts.TilePadding*2
 	canvasSize := tileWidth*opts.Tiles + opts.ImagePadding*2
 
-	// Create the base image
-	im := image.NewRGBA(image.Rect(0, 0, canvasSize, canvasSize))
-
-	// Fill the background
-	for y := 0; y < im.Bounds().Dy(); y++ {
-		for x := 0; x < im.Bounds().Dx(); x++ {
-			im.Set(x, y, bgColor)
-		}
-	}
+	return &renderPlan{
+		raw:         raw,
+		pic:         pic,
+		dimension:   dimension,
+		tiles:       opts.Tiles,
+		tileSize:    opts.TileSize,
+		tilePadding: opts.TilePadding,
+		imgPadding:  opts.ImagePadding,
+		tileWidth:   tileWidth,
+		canvasSize:  canvasSize,
+		bgColor:     bgColor,
+		tileColor:   tileColor,
+	}, nil
+}
 
-	// Draw the tiles
-	for y := 0; y < dimension; y++ {
-		for x := 0; x < dimension; x++ {
-			if pic[y][x] == 1 {
-				x0 := (x * tileWidth) + opts.TilePadding + opts.ImagePadding
-				y0 := (y * tileWidth) + opts.TilePadding + opts.ImagePadding
-
-				// Draw the rectangle tile
-				for py := y0; py < y0+opts.TileSize; py++ {
-					for px := x0; px < x0+opts.TileSize; px++ {
-						im.Set(px, py, tileColor)
-					}
-				}
+// draw paints the plan's background and tiles into im. im may be any
+// draw.Image, including a paletted image used by the GIF encoder. It
+// fills with draw.Draw rather than looping pixel-by-pixel with Set, so
+// the cost of one tile is a single copy/convert pass over its rectangle
+// instead of one color.Model conversion per pixel.
+func (p *renderPlan) draw(im draw.Image) {
+	bounds := im.Bounds()
+	draw.Draw(im, bounds, &image.Uniform{p.bgColor}, image.Point{}, draw.Src)
+
+	for y := 0; y < p.dimension; y++ {
+		for x := 0; x < p.dimension; x++ {
+			if p.pic[y][x] == 1 {
+				x0 := bounds.Min.X + (x * p.tileWidth) + p.tilePadding + p.imgPadding
+				y0 := bounds.Min.Y + (y * p.tileWidth) + p.tilePadding + p.imgPadding
+				tileRect := image.Rect(x0, y0, x0+p.tileSize, y0+p.tileSize)
+				draw.Draw(im, tileRect, &image.Uniform{p.tileColor}, image.Point{}, draw.Src)
 			}
 		}
 	}
-
-	return im, nil
 }
 
 // MustNew creates a new retricon image or panics on error
@@ -284,12 +361,22 @@ func fixedLengthHash(buf []byte, length int) ([]byte, error) {
 	return result, nil
 }
 
-// idHash generates a hash with specific fill characteristics
-func idHash(name string, length int, minFill, maxFill float64, useColors bool) (*rawData, error) {
+// idHash generates a hash with specific fill characteristics. When
+// palette is non-empty, the two generated colors are chosen by indexing
+// into it instead of being built from freeform hash bytes, so the
+// result stays within the caller's palette while remaining deterministic
+// per name.
+func idHash(name string, length int, minFill, maxFill float64, useColors bool, palette Palette) (*rawData, error) {
 	buf := []byte(name + " ")
 	neededBytes := int(math.Ceil(float64(length) / 8.0))
+	colorBytes := 0
 	if useColors {
-		neededBytes += 6
+		if len(palette) > 0 {
+			colorBytes = 2
+		} else {
+			colorBytes = 6
+		}
+		neededBytes += colorBytes
 	}
 
 	for i := 0; i < 256; i++ {
@@ -307,9 +394,21 @@ func idHash(name string, length int, minFill, maxFill float64, useColors bool) (
 		var colors []color.RGBA
 
 		if useColors {
-			colors = []color.RGBA{
-				{fp[0], fp[1], fp[2], 255},
-				{fp[3], fp[4], fp[5], 255},
+			if len(palette) > 0 {
+				bgIdx := int(fp[0]) % len(palette)
+				tileIdx := int(fp[1]) % len(palette)
+				if bgIdx == tileIdx {
+					continue
+				}
+				colors = []color.RGBA{
+					palette[bgIdx],
+					palette[tileIdx],
+				}
+			} else {
+				colors = []color.RGBA{
+					{fp[0], fp[1], fp[2], 255},
+					{fp[3], fp[4], fp[5], 255},
+				}
 			}
 
 			// Sort colors by brightness
@@ -317,7 +416,7 @@ func idHash(name string, length int, minFill, maxFill float64, useColors bool) (
 				colors[0], colors[1] = colors[1], colors[0]
 			}
 
-			fp = fp[6:]
+			fp = fp[colorBytes:]
 		} else {
 			colors = []color.RGBA{}
 		}
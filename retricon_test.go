@@ -75,7 +75,7 @@ func TestCustomOptions(t *testing.T) {
 		t.Fatalf("Failed to generate retricon with custom options: %v", err)
 	}
 
-	expectedSize := 499
+	expectedSize := 500
 	if img.Bounds().Dx() != expectedSize || img.Bounds().Dy() != expectedSize {
 		t.Errorf("Expected image size to be %d, got %dx%d",
 			expectedSize, img.Bounds().Dx(), img.Bounds().Dy())
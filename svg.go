@@ -0,0 +1,95 @@
+package retricon
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+	"strings"
+)
+
+// NewSVG renders the identicon for name as a compact SVG document. The
+// result scales to any resolution without re-generating pixels, making
+// it cheaper to serve than a raster format for avatar endpoints.
+func NewSVG(name string, opts Options) ([]byte, error) {
+	var buf strings.Builder
+	if err := WriteSVG(&buf, name, opts); err != nil {
+		return nil, err
+	}
+	return []byte(buf.String()), nil
+}
+
+// WriteSVG renders the identicon for name and writes its SVG document to w.
+func WriteSVG(w io.Writer, name string, opts Options) error {
+	plan, err := planRender(name, opts)
+	if err != nil {
+		return err
+	}
+
+	var buf strings.Builder
+	plan.writeSVG(&buf, opts)
+
+	_, err = io.WriteString(w, buf.String())
+	return err
+}
+
+// writeSVG walks the plan's pixel grid and emits one background rect
+// plus one rect per run of horizontally-adjacent "on" tiles in a row,
+// merging runs to keep the document small.
+func (p *renderPlan) writeSVG(buf *strings.Builder, opts Options) {
+	size := p.canvasSize
+	width := size
+	if opts.Size > 0 {
+		width = opts.Size
+	}
+
+	fmt.Fprintf(buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+		width, width, size, size)
+	if p.bgColor.A > 0 {
+		fmt.Fprintf(buf, `<rect width="%d" height="%d" fill="%s"%s/>`+"\n",
+			size, size, hexColor(p.bgColor), fillOpacity(p.bgColor))
+	}
+
+	if p.tileColor.A > 0 {
+		tileFill := hexColor(p.tileColor)
+		tileOpacity := fillOpacity(p.tileColor)
+		for y := 0; y < p.dimension; y++ {
+			for x := 0; x < p.dimension; {
+				if p.pic[y][x] != 1 {
+					x++
+					continue
+				}
+
+				start := x
+				for x < p.dimension && p.pic[y][x] == 1 {
+					x++
+				}
+
+				x0 := p.imgPadding + p.tilePadding + start*p.tileWidth
+				y0 := p.imgPadding + p.tilePadding + y*p.tileWidth
+				runWidth := (x-start-1)*p.tileWidth + p.tileSize
+
+				fmt.Fprintf(buf, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"%s/>`+"\n",
+					x0, y0, runWidth, p.tileSize, tileFill, tileOpacity)
+			}
+		}
+	}
+
+	buf.WriteString("</svg>\n")
+}
+
+// hexColor formats c as a CSS hex color, ignoring alpha; callers combine
+// it with fillOpacity to reproduce c's alpha channel.
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// fillOpacity returns a ` fill-opacity="..."` attribute reflecting c.A,
+// or "" when c is fully opaque, so transparent Options.BgColor/TileColor
+// (e.g. the Default style's zero-value background) render as transparent
+// SVG instead of an opaque black rect, matching the raster encoders.
+func fillOpacity(c color.RGBA) string {
+	if c.A == 255 {
+		return ""
+	}
+	return fmt.Sprintf(` fill-opacity="%.3f"`, float64(c.A)/255)
+}
@@ -0,0 +1,75 @@
+package retricon
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestNewSVG(t *testing.T) {
+	opts := Options{Tiles: 5, TileSize: 10}
+
+	svg, err := NewSVG("test", opts)
+	if err != nil {
+		t.Fatalf("NewSVG failed: %v", err)
+	}
+
+	s := string(svg)
+	if !strings.HasPrefix(s, "<svg") {
+		t.Errorf("expected document to start with <svg, got %q", s[:minInt(20, len(s))])
+	}
+	if !strings.Contains(s, "viewBox") {
+		t.Error("expected a viewBox attribute")
+	}
+	if !strings.HasSuffix(strings.TrimSpace(s), "</svg>") {
+		t.Error("expected document to end with </svg>")
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteSVG(&buf, "test", Options{Tiles: 4, TileSize: 8}); err != nil {
+		t.Fatalf("WriteSVG failed: %v", err)
+	}
+	if buf.Len() == 0 {
+		t.Error("WriteSVG wrote no bytes")
+	}
+}
+
+func TestNewSVGInvalidOptions(t *testing.T) {
+	_, err := NewSVG("test", Options{Tiles: 0, TileSize: 10})
+	if err == nil {
+		t.Error("Expected error for invalid tiles, got nil")
+	}
+}
+
+func TestNewSVGHonorsSize(t *testing.T) {
+	svg, err := NewSVG("test", Options{Tiles: 5, TileSize: 10, Size: 256})
+	if err != nil {
+		t.Fatalf("NewSVG failed: %v", err)
+	}
+	if !strings.Contains(string(svg), `width="256"`) {
+		t.Error(`expected document to declare width="256"`)
+	}
+}
+
+func TestNewSVGTransparentBackground(t *testing.T) {
+	// BgColor left unset resolves to color.RGBA{} (fully transparent),
+	// the same default the raster encoders use for the Default style.
+	svg, err := NewSVG("test", Options{Tiles: 5, TileSize: 10})
+	if err != nil {
+		t.Fatalf("NewSVG failed: %v", err)
+	}
+
+	s := string(svg)
+	if strings.Contains(s, `fill="#000000"`) {
+		t.Error("expected a transparent BgColor to be omitted, not rendered as an opaque black rect")
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}